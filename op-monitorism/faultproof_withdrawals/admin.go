@@ -0,0 +1,158 @@
+package faultproof_withdrawals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/monitorism/op-monitorism/faultproof_withdrawals/validator"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// AdminNamespace is the JSON-RPC namespace AdminAPI is served under, so its
+// methods are reachable as faultproof_listInProgressAttacks,
+// faultproof_listDefenderWinsAttacks, and so on.
+const AdminNamespace = "faultproof"
+
+// AdminSyncStatus mirrors the information LogState logs, shaped for
+// programmatic consumption instead of a log line.
+type AdminSyncStatus struct {
+	InitialL1Height uint64 `json:"initialL1Height"`
+	NextL1Height    uint64 `json:"nextL1Height"`
+	LatestL1Height  uint64 `json:"latestL1Height"`
+	LatestL2Height  uint64 `json:"latestL2Height"`
+	BlockToProcess  uint64 `json:"blockToProcess"`
+	SyncPercentage  uint64 `json:"syncPercentage"`
+}
+
+// AdminAPI exposes every chain registered with a ChainMonitor as a JSON-RPC
+// surface that is read-only apart from ResetCursor, in the spirit of
+// geth/erigon's admin_* namespace, so
+// on-call responders can inspect the monitor's in-memory maps and force a
+// re-scan without restarting the process or scraping Prometheus label
+// cardinality. Every method takes the target chain's name as its first
+// argument so one AdminAPI instance serves all chains a process monitors.
+type AdminAPI struct {
+	monitor *ChainMonitor
+}
+
+// NewAdminAPI returns an AdminAPI serving every chain registered with
+// monitor, including any added or removed after this call.
+func NewAdminAPI(monitor *ChainMonitor) *AdminAPI {
+	return &AdminAPI{monitor: monitor}
+}
+
+// APIs returns the rpc.API descriptors for registering AdminAPI with an
+// rpc.Server, the same shape node.Node and op-node use to expose their own
+// namespaces.
+func (a *AdminAPI) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: AdminNamespace,
+			Service:   a,
+		},
+	}
+}
+
+// resolve looks up the State registered under chain, or an error naming the
+// chain if it is not currently monitored.
+func (a *AdminAPI) resolve(chain string) (*State, error) {
+	state, ok := a.monitor.Chain(chain)
+	if !ok {
+		return nil, fmt.Errorf("chain %q is not currently monitored", chain)
+	}
+	return state, nil
+}
+
+// ListInProgressAttacks returns every enriched withdrawal event currently
+// flagged as a potential attack on a dispute game that has not resolved yet.
+func (a *AdminAPI) ListInProgressAttacks(ctx context.Context, chain string) ([]*validator.EnrichedProvenWithdrawalEvent, error) {
+	state, err := a.resolve(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	events := make([]*validator.EnrichedProvenWithdrawalEvent, 0, len(state.potentialAttackOnInProgressGames))
+	for _, event := range state.potentialAttackOnInProgressGames {
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// ListDefenderWinsAttacks returns every enriched withdrawal event flagged as
+// a forgery on a dispute game that already resolved with DefenderWins.
+func (a *AdminAPI) ListDefenderWinsAttacks(ctx context.Context, chain string) ([]*validator.EnrichedProvenWithdrawalEvent, error) {
+	state, err := a.resolve(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	events := make([]*validator.EnrichedProvenWithdrawalEvent, 0, len(state.potentialAttackOnDefenderWinsGames))
+	for _, event := range state.potentialAttackOnDefenderWinsGames {
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// GetWithdrawal returns the enriched withdrawal event tracked under txHash
+// on chain, searching all three maps State keeps, or an error if it is not
+// currently being monitored.
+func (a *AdminAPI) GetWithdrawal(ctx context.Context, chain string, txHash common.Hash) (*validator.EnrichedProvenWithdrawalEvent, error) {
+	state, err := a.resolve(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	if event, ok := state.potentialAttackOnDefenderWinsGames[txHash]; ok {
+		return event, nil
+	}
+	if event, ok := state.potentialAttackOnInProgressGames[txHash]; ok {
+		return event, nil
+	}
+	if cached, ok := state.suspiciousEventsOnChallengerWinsGames.Get(txHash); ok {
+		return cached.(*validator.EnrichedProvenWithdrawalEvent), nil
+	}
+	return nil, fmt.Errorf("withdrawal %s is not currently tracked on chain %q", txHash, chain)
+}
+
+// SyncStatus reports chain's current L1 cursor and how far behind the chain
+// head it is.
+func (a *AdminAPI) SyncStatus(ctx context.Context, chain string) (*AdminSyncStatus, error) {
+	state, err := a.resolve(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	blockToProcess, syncPercentage := state.getPercentagesLocked()
+	return &AdminSyncStatus{
+		InitialL1Height: state.initialL1Height,
+		NextL1Height:    state.nextL1Height,
+		LatestL1Height:  state.latestL1Height,
+		LatestL2Height:  state.latestL2Height,
+		BlockToProcess:  blockToProcess,
+		SyncPercentage:  syncPercentage,
+	}, nil
+}
+
+// ResetCursor forces chain's monitor to resume scanning from height, letting
+// an operator re-scan a suspicious L1 range without restarting the process.
+func (a *AdminAPI) ResetCursor(ctx context.Context, chain string, height hexutil.Uint64) error {
+	state, err := a.resolve(chain)
+	if err != nil {
+		return err
+	}
+	return state.SetNextL1Height(uint64(height))
+}
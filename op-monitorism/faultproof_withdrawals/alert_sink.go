@@ -0,0 +1,293 @@
+package faultproof_withdrawals
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/monitorism/op-monitorism/faultproof_withdrawals/validator"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// AlertSeverity classifies how urgently an alert needs a human to look at
+// it. Severity is derived from which State map the triggering event landed
+// in, not from anything a sink configures.
+type AlertSeverity string
+
+const (
+	AlertSeverityCritical AlertSeverity = "critical" // forgery on a resolved DefenderWins game
+	AlertSeverityWarning  AlertSeverity = "warning"   // forgery on a game still in progress
+	AlertSeverityInfo     AlertSeverity = "info"      // suspicious event on a correctly resolved ChallengerWins game
+)
+
+// severityRank orders AlertSeverity from least to most urgent, so a
+// dispatch can tell whether it escalates a previously sent alert.
+var severityRank = map[AlertSeverity]int{
+	AlertSeverityInfo:     0,
+	AlertSeverityWarning:  1,
+	AlertSeverityCritical: 2,
+}
+
+// AlertEvent is what gets dispatched to every configured AlertSink whenever
+// one of State's Increment* methods records a forgery or suspicious event.
+type AlertEvent struct {
+	Severity  AlertSeverity
+	TxHash    common.Hash
+	Event     *validator.EnrichedProvenWithdrawalEvent
+	Timestamp time.Time
+}
+
+// AlertSink delivers an AlertEvent to an external system: a webhook, Slack,
+// PagerDuty, OpsGenie, or stdout. Send should return a non-nil error for the
+// AlertDispatcher to retry; implementations do not need to retry internally.
+type AlertSink interface {
+	Name() string
+	Send(ctx context.Context, alert AlertEvent) error
+}
+
+// AlertDispatcherConfig tunes the retry, queueing, and deduplication
+// behavior shared by every sink registered with an AlertDispatcher.
+type AlertDispatcherConfig struct {
+	QueueSize      int           // bounded in-memory queue; oldest alert is dropped once full
+	MaxRetries     int           // per-sink retries after the first failed attempt before giving up on an alert
+	InitialBackoff time.Duration // backoff before the first retry
+	MaxBackoff     time.Duration // backoff is doubled after every retry up to this ceiling
+	DedupeCooldown time.Duration // suppress repeat alerts for the same TxHash within this window
+}
+
+// DefaultAlertDispatcherConfig matches what a security-critical forgery
+// detector should ship with out of the box: small bursts tolerated, retries
+// bounded, and the same withdrawal hash not re-alerted every poll loop.
+func DefaultAlertDispatcherConfig() AlertDispatcherConfig {
+	return AlertDispatcherConfig{
+		QueueSize:      256,
+		MaxRetries:     5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		DedupeCooldown: 15 * time.Minute,
+	}
+}
+
+// AlertDispatcher fans an AlertEvent out to every registered AlertSink,
+// retrying each sink independently with exponential backoff, deduplicating
+// repeat alerts for the same TxHash within a cooldown window, and dropping
+// the oldest queued alert rather than blocking the caller when the queue is
+// full.
+type AlertDispatcher struct {
+	logger log.Logger
+	config AlertDispatcherConfig
+	sinks  []AlertSink
+
+	queue chan AlertEvent
+
+	mu         sync.Mutex
+	lastAlerts map[common.Hash]lastAlert
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// lastAlert records the severity and time of the most recent alert sent for
+// a given TxHash, so a later escalation can be told apart from a repeat.
+type lastAlert struct {
+	severity AlertSeverity
+	sentAt   time.Time
+}
+
+// NewAlertDispatcher starts a dispatcher that delivers alerts to sinks in
+// the background. Call Close to stop it.
+func NewAlertDispatcher(logger log.Logger, config AlertDispatcherConfig, sinks ...AlertSink) *AlertDispatcher {
+	d := &AlertDispatcher{
+		logger:     logger,
+		config:     config,
+		sinks:      sinks,
+		queue:      make(chan AlertEvent, config.QueueSize),
+		lastAlerts: make(map[common.Hash]lastAlert),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	go d.run()
+	return d
+}
+
+// Dispatch enqueues alert for delivery to every registered sink, unless an
+// alert for the same TxHash was already sent within DedupeCooldown at the
+// same or higher severity. An alert that escalates a TxHash's severity
+// (e.g. a Warning followed by a Critical once the same game resolves as a
+// forgery) always bypasses the cooldown, so an escalation is never
+// swallowed by the cooldown set by its own precursor. If the queue is full,
+// the oldest queued alert is dropped to make room.
+func (d *AlertDispatcher) Dispatch(alert AlertEvent) {
+	if d == nil || len(d.sinks) == 0 {
+		return
+	}
+
+	d.mu.Lock()
+	if last, ok := d.lastAlerts[alert.TxHash]; ok &&
+		severityRank[alert.Severity] <= severityRank[last.severity] &&
+		alert.Timestamp.Sub(last.sentAt) < d.config.DedupeCooldown {
+		d.mu.Unlock()
+		return
+	}
+	d.lastAlerts[alert.TxHash] = lastAlert{severity: alert.Severity, sentAt: alert.Timestamp}
+	d.mu.Unlock()
+
+	select {
+	case d.queue <- alert:
+	default:
+		select {
+		case dropped := <-d.queue:
+			d.logger.Warn("STATE ALERT: queue full, dropping oldest alert", "TxHash", dropped.TxHash)
+		default:
+		}
+		select {
+		case d.queue <- alert:
+		default:
+			d.logger.Warn("STATE ALERT: queue still full after dropping oldest, discarding alert", "TxHash", alert.TxHash)
+		}
+	}
+}
+
+func (d *AlertDispatcher) run() {
+	defer close(d.done)
+	for {
+		select {
+		case alert := <-d.queue:
+			d.deliver(alert)
+		case <-d.stop:
+			return
+		}
+	}
+}
+
+func (d *AlertDispatcher) deliver(alert AlertEvent) {
+	var wg sync.WaitGroup
+	for _, sink := range d.sinks {
+		wg.Add(1)
+		go func(sink AlertSink) {
+			defer wg.Done()
+			d.deliverToSink(sink, alert)
+		}(sink)
+	}
+	wg.Wait()
+}
+
+func (d *AlertDispatcher) deliverToSink(sink AlertSink, alert AlertEvent) {
+	backoff := d.config.InitialBackoff
+	var lastErr error
+	for attempt := 0; attempt <= d.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > d.config.MaxBackoff {
+				backoff = d.config.MaxBackoff
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		lastErr = sink.Send(ctx, alert)
+		cancel()
+		if lastErr == nil {
+			return
+		}
+		d.logger.Warn("STATE ALERT: sink delivery failed, retrying", "sink", sink.Name(), "TxHash", alert.TxHash, "attempt", attempt, "error", lastErr)
+	}
+	d.logger.Error("STATE ALERT: sink delivery failed permanently", "sink", sink.Name(), "TxHash", alert.TxHash, "error", lastErr)
+}
+
+// Close stops the dispatcher's delivery loop. Alerts still queued when Close
+// is called are not drained and may be dropped without being delivered.
+func (d *AlertDispatcher) Close() {
+	if d == nil {
+		return
+	}
+	close(d.stop)
+	<-d.done
+}
+
+// alertJSON is the wire shape for sinks that speak JSON (stdout, generic
+// webhooks).
+type alertJSON struct {
+	Severity  AlertSeverity `json:"severity"`
+	TxHash    string        `json:"txHash"`
+	Timestamp time.Time     `json:"timestamp"`
+	Event     interface{}   `json:"event"`
+}
+
+func alertToJSON(alert AlertEvent) alertJSON {
+	return alertJSON{
+		Severity:  alert.Severity,
+		TxHash:    alert.TxHash.String(),
+		Timestamp: alert.Timestamp,
+		Event:     alert.Event,
+	}
+}
+
+// StdoutAlertSink writes each alert as a JSON line to the given logger. It
+// is the zero-configuration sink every dispatcher can fall back to.
+type StdoutAlertSink struct {
+	logger log.Logger
+}
+
+func NewStdoutAlertSink(logger log.Logger) *StdoutAlertSink {
+	return &StdoutAlertSink{logger: logger}
+}
+
+func (s *StdoutAlertSink) Name() string { return "stdout" }
+
+func (s *StdoutAlertSink) Send(ctx context.Context, alert AlertEvent) error {
+	payload, err := json.Marshal(alertToJSON(alert))
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert: %w", err)
+	}
+	s.logger.Info("STATE ALERT", "severity", alert.Severity, "json", string(payload))
+	return nil
+}
+
+// WebhookAlertSink POSTs each alert as JSON to a configured URL. It is
+// generic enough to front Slack incoming webhooks, PagerDuty's Events API,
+// OpsGenie's alert API, or any other HTTP receiver that accepts JSON.
+type WebhookAlertSink struct {
+	name       string
+	url        string
+	httpClient *http.Client
+}
+
+func NewWebhookAlertSink(name, url string) *WebhookAlertSink {
+	return &WebhookAlertSink{
+		name:       name,
+		url:        url,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *WebhookAlertSink) Name() string { return s.name }
+
+func (s *WebhookAlertSink) Send(ctx context.Context, alert AlertEvent) error {
+	payload, err := json.Marshal(alertToJSON(alert))
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver webhook alert: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned status %d", s.name, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package faultproof_withdrawals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// recordingAlertSink records every alert it receives on a channel so a test
+// can assert on delivery order and count without racing AlertDispatcher's
+// background delivery goroutine.
+type recordingAlertSink struct {
+	received chan AlertEvent
+}
+
+func newRecordingAlertSink() *recordingAlertSink {
+	return &recordingAlertSink{received: make(chan AlertEvent, 16)}
+}
+
+func (s *recordingAlertSink) Name() string { return "recording" }
+
+func (s *recordingAlertSink) Send(ctx context.Context, alert AlertEvent) error {
+	s.received <- alert
+	return nil
+}
+
+func (s *recordingAlertSink) expectDelivered(t *testing.T, severity AlertSeverity) {
+	t.Helper()
+	select {
+	case alert := <-s.received:
+		if alert.Severity != severity {
+			t.Fatalf("expected delivered alert with severity %q, got %q", severity, alert.Severity)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected an alert with severity %q to be delivered, got none", severity)
+	}
+}
+
+func (s *recordingAlertSink) expectNoDelivery(t *testing.T) {
+	t.Helper()
+	select {
+	case alert := <-s.received:
+		t.Fatalf("expected no alert to be delivered, got severity %q", alert.Severity)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestAlertDispatcherDedupeCooldown(t *testing.T) {
+	sink := newRecordingAlertSink()
+	config := DefaultAlertDispatcherConfig()
+	config.DedupeCooldown = time.Hour
+	dispatcher := NewAlertDispatcher(log.New(), config, sink)
+	defer dispatcher.Close()
+
+	txHash := common.HexToHash("0x1")
+	now := time.Now()
+
+	dispatcher.Dispatch(AlertEvent{Severity: AlertSeverityWarning, TxHash: txHash, Timestamp: now})
+	sink.expectDelivered(t, AlertSeverityWarning)
+
+	// A repeat at the same severity within the cooldown is deduped.
+	dispatcher.Dispatch(AlertEvent{Severity: AlertSeverityWarning, TxHash: txHash, Timestamp: now.Add(time.Minute)})
+	sink.expectNoDelivery(t)
+}
+
+func TestAlertDispatcherEscalationBypassesCooldown(t *testing.T) {
+	sink := newRecordingAlertSink()
+	config := DefaultAlertDispatcherConfig()
+	config.DedupeCooldown = time.Hour
+	dispatcher := NewAlertDispatcher(log.New(), config, sink)
+	defer dispatcher.Close()
+
+	txHash := common.HexToHash("0x2")
+	now := time.Now()
+
+	dispatcher.Dispatch(AlertEvent{Severity: AlertSeverityWarning, TxHash: txHash, Timestamp: now})
+	sink.expectDelivered(t, AlertSeverityWarning)
+
+	// A Critical escalation for the same TxHash must not be swallowed by the
+	// Warning's cooldown, even though it arrives well within it.
+	dispatcher.Dispatch(AlertEvent{Severity: AlertSeverityCritical, TxHash: txHash, Timestamp: now.Add(time.Minute)})
+	sink.expectDelivered(t, AlertSeverityCritical)
+
+	// Once Critical has been recorded, a later Warning for the same TxHash is
+	// a de-escalation and stays deduped within the cooldown.
+	dispatcher.Dispatch(AlertEvent{Severity: AlertSeverityWarning, TxHash: txHash, Timestamp: now.Add(2 * time.Minute)})
+	sink.expectNoDelivery(t)
+}
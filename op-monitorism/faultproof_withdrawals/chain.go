@@ -0,0 +1,17 @@
+package faultproof_withdrawals
+
+import "github.com/ethereum/go-ethereum/common"
+
+// ChainConfig identifies one (L1, L2, OptimismPortal, DisputeGameFactory)
+// target being monitored. See ChainMonitor for why a process passes one of
+// these per chain into NewState instead of running one process per network.
+type ChainConfig struct {
+	// Name is used as the "chain" label on every Prometheus metric this
+	// State's events feed into, e.g. "op-mainnet", "base", "zora", "mode".
+	Name string
+
+	PortalAddress common.Address
+
+	L1RPCURL string
+	L2RPCURL string
+}
@@ -0,0 +1,85 @@
+package faultproof_withdrawals
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ChainMonitor owns one State per monitored chain so a single process can
+// track N concurrent (L1, L2, OptimismPortal, DisputeGameFactory) targets —
+// e.g. OP Mainnet, Base, Zora, and Mode — behind one shared Metrics instance
+// and one /metrics endpoint, instead of one process per network.
+type ChainMonitor struct {
+	mu     sync.RWMutex
+	states map[string]*State
+}
+
+// NewChainMonitor returns an empty ChainMonitor. Use AddChain to register
+// each chain's State.
+func NewChainMonitor() *ChainMonitor {
+	return &ChainMonitor{states: make(map[string]*State)}
+}
+
+// AddChain registers state under its chain's name, replacing any
+// previously registered State for that same chain.
+func (c *ChainMonitor) AddChain(state *State) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.states[state.GetChainName()] = state
+}
+
+// RemoveChain stops a chain from being monitored.
+func (c *ChainMonitor) RemoveChain(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.states, name)
+}
+
+// Chain returns the State registered under name, if any.
+func (c *ChainMonitor) Chain(name string) (*State, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	state, ok := c.states[name]
+	return state, ok
+}
+
+// Chains returns the name of every currently registered chain, sorted for
+// deterministic output.
+func (c *ChainMonitor) Chains() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	names := make([]string, 0, len(c.states))
+	for name := range c.states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UpdateMetrics refreshes m from every registered chain's State.
+func (c *ChainMonitor) UpdateMetrics(m *Metrics) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, state := range c.states {
+		m.UpdateMetricsFromState(state)
+	}
+}
+
+// APIs returns the rpc.API descriptors for an AdminAPI covering every chain
+// registered with c, including any added or removed after this call. A
+// process monitoring multiple chains registers these once, rather than one
+// AdminAPI per chain.
+func (c *ChainMonitor) APIs() []rpc.API {
+	return NewAdminAPI(c).APIs()
+}
+
+// LogStates logs every registered chain's State.
+func (c *ChainMonitor) LogStates() {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, state := range c.states {
+		state.LogState()
+	}
+}
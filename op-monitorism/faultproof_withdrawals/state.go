@@ -3,6 +3,7 @@ package faultproof_withdrawals
 import (
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/ethereum-optimism/monitorism/op-monitorism/faultproof_withdrawals/validator"
@@ -19,7 +20,14 @@ const (
 )
 
 type State struct {
-	logger          log.Logger
+	logger log.Logger
+	chain  ChainConfig
+
+	// mu guards every field below that the scan loop's Increment* methods
+	// write and the Admin API (chunk0-2) reads from its own rpc.Server
+	// goroutines, so a query can never race a scan iteration.
+	mu sync.RWMutex
+
 	nextL1Height    uint64
 	latestL1Height  uint64
 	initialL1Height uint64
@@ -48,9 +56,42 @@ type State struct {
 	numberOfSuspiciousEventsOnChallengerWinsGames uint64
 
 	provenWithdrawalValidator *validator.ProvenWithdrawalValidator
+
+	// store persists the cursor and open events so a restart can resume
+	// monitoring instead of re-scanning from initialL1Height. Defaults to
+	// nullStateStore, which keeps the previous in-memory-only behavior.
+	store StateStore
+
+	// alertDispatcher fans out a forgery or suspicious event to any
+	// configured AlertSinks. Nil if no sinks were configured, in which case
+	// dispatching an alert is a no-op.
+	alertDispatcher *AlertDispatcher
 }
 
-func NewState(logger log.Logger, provenWithdrawalValidator *validator.ProvenWithdrawalValidator) (*State, error) {
+// StateOption configures optional State dependencies. It follows the same
+// pattern as op-service's functional option constructors.
+type StateOption func(*State)
+
+// WithStateStore overrides the default nullStateStore with store, so State
+// hydrates its cursor and open events from it on startup and persists every
+// subsequent change to it.
+func WithStateStore(store StateStore) StateOption {
+	return func(s *State) {
+		s.store = store
+	}
+}
+
+// WithAlertDispatcher registers dispatcher so that every forgery on a
+// defender-wins game, every not-yet-resolved in-progress attack, and every
+// suspicious challenger-wins event is sent to dispatcher's AlertSinks, in
+// addition to the existing log lines and Prometheus gauges.
+func WithAlertDispatcher(dispatcher *AlertDispatcher) StateOption {
+	return func(s *State) {
+		s.alertDispatcher = dispatcher
+	}
+}
+
+func NewState(logger log.Logger, chain ChainConfig, provenWithdrawalValidator *validator.ProvenWithdrawalValidator, opts ...StateOption) (*State, error) {
 	nextL1Height, err := provenWithdrawalValidator.GetL1BlockNumber()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get L1 block number: %w", err)
@@ -91,12 +132,61 @@ func NewState(logger log.Logger, provenWithdrawalValidator *validator.ProvenWith
 		initialL1Height:           nextL1Height,
 		latestL2Height:            latestL2Height,
 		logger:                    logger,
+		chain:                     chain,
 		provenWithdrawalValidator: provenWithdrawalValidator,
+		store:                     nullStateStore{},
+	}
+
+	for _, opt := range opts {
+		opt(&ret)
+	}
+
+	if err := ret.hydrateFromStore(); err != nil {
+		return nil, fmt.Errorf("failed to hydrate state from store: %w", err)
 	}
 
 	return &ret, nil
 }
 
+// hydrateFromStore loads the persisted cursor and open events from s.store,
+// if any were previously saved, so a restart resumes monitoring instead of
+// re-scanning from initialL1Height and losing track of in-progress games.
+func (s *State) hydrateFromStore() error {
+	if height, found, err := s.store.LoadCursor(); err != nil {
+		return fmt.Errorf("failed to load cursor: %w", err)
+	} else if found {
+		s.nextL1Height = height
+	}
+
+	openEvents, err := s.store.LoadOpenEvents()
+	if err != nil {
+		return fmt.Errorf("failed to load open events: %w", err)
+	}
+
+	if events, ok := openEvents[CategoryPotentialAttackOnDefenderWinsGames]; ok {
+		s.potentialAttackOnDefenderWinsGames = events
+		s.numberOfPotentialAttacksOnDefenderWinsGames = uint64(len(events))
+	}
+	if events, ok := openEvents[CategoryPotentialAttackOnInProgressGames]; ok {
+		s.potentialAttackOnInProgressGames = events
+		s.numberOfPotentialAttackOnInProgressGames = uint64(len(events))
+	}
+	if events, ok := openEvents[CategorySuspiciousEventsOnChallengerWinsGames]; ok {
+		for key, event := range events {
+			s.suspiciousEventsOnChallengerWinsGames.Add(key, event)
+		}
+		s.numberOfSuspiciousEventsOnChallengerWinsGames = uint64(len(events))
+	}
+
+	return nil
+}
+
+// GetChainName returns the "chain" label this State's metrics are reported
+// under.
+func (s *State) GetChainName() string {
+	return s.chain.Name
+}
+
 func (s *State) GetNodeConnectionFailures() uint64 {
 	return s.provenWithdrawalValidator.L1Proxy.GetTotalConnectionErrors() + s.provenWithdrawalValidator.L2Proxy.GetTotalConnectionErrors()
 }
@@ -105,8 +195,24 @@ func (s *State) GetNodeConnections() uint64 {
 	return s.provenWithdrawalValidator.L1Proxy.GetTotalConnections() + s.provenWithdrawalValidator.L2Proxy.GetTotalConnections()
 }
 
+// SetNextL1Height updates the L1 cursor and persists it to the store, so the
+// next restart resumes from height instead of initialL1Height.
+func (s *State) SetNextL1Height(height uint64) error {
+	s.mu.Lock()
+	s.nextL1Height = height
+	s.mu.Unlock()
+
+	if err := s.store.SaveCursor(height); err != nil {
+		return fmt.Errorf("failed to save cursor: %w", err)
+	}
+	return nil
+}
+
 func (s *State) LogState() {
-	blockToProcess, syncPercentage := s.GetPercentages()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blockToProcess, syncPercentage := s.getPercentagesLocked()
 
 	s.logger.Info("STATE:",
 		"withdrawalsProcessed", fmt.Sprintf("%d", s.withdrawalsProcessed),
@@ -129,7 +235,11 @@ func (s *State) LogState() {
 
 func (s *State) IncrementWithdrawalsValidated(enrichedWithdrawalEvent *validator.EnrichedProvenWithdrawalEvent) {
 	s.logger.Info("STATE WITHDRAWAL: valid", "TxHash", fmt.Sprintf("%v", enrichedWithdrawalEvent.Event.Raw.TxHash), "enrichedWithdrawalEvent", enrichedWithdrawalEvent)
+
+	s.mu.Lock()
 	s.withdrawalsProcessed++
+	s.mu.Unlock()
+
 	enrichedWithdrawalEvent.ProcessedTimeStamp = float64(time.Now().Unix())
 }
 
@@ -137,22 +247,38 @@ func (s *State) IncrementPotentialAttackOnDefenderWinsGames(enrichedWithdrawalEv
 	key := enrichedWithdrawalEvent.Event.Raw.TxHash
 
 	s.logger.Error("STATE WITHDRAWAL: is NOT valid, forgery detected", "TxHash", fmt.Sprintf("%v", enrichedWithdrawalEvent.Event.Raw.TxHash), "enrichedWithdrawalEvent", enrichedWithdrawalEvent)
+
+	s.mu.Lock()
 	s.potentialAttackOnDefenderWinsGames[key] = enrichedWithdrawalEvent
 	s.numberOfPotentialAttacksOnDefenderWinsGames++
-
 	if _, ok := s.potentialAttackOnInProgressGames[key]; ok {
 		s.logger.Error("STATE WITHDRAWAL: added to potential attacks. Removing from inProgress", "TxHash", fmt.Sprintf("%v", enrichedWithdrawalEvent.Event.Raw.TxHash), "enrichedWithdrawalEvent", enrichedWithdrawalEvent)
 		delete(s.potentialAttackOnInProgressGames, key)
 		s.numberOfPotentialAttackOnInProgressGames--
 	}
-
 	s.withdrawalsProcessed++
 	enrichedWithdrawalEvent.ProcessedTimeStamp = float64(time.Now().Unix())
+	s.mu.Unlock()
+
+	if err := s.store.UpsertEnrichedEvent(CategoryPotentialAttackOnDefenderWinsGames, enrichedWithdrawalEvent); err != nil {
+		s.logger.Error("STATE WITHDRAWAL: failed to persist potential attack on defender wins games", "TxHash", fmt.Sprintf("%v", key), "error", err)
+	}
+	if err := s.store.DeleteEvent(CategoryPotentialAttackOnInProgressGames, key); err != nil {
+		s.logger.Error("STATE WITHDRAWAL: failed to remove persisted in-progress event", "TxHash", fmt.Sprintf("%v", key), "error", err)
+	}
 
+	s.alertDispatcher.Dispatch(AlertEvent{
+		Severity:  AlertSeverityCritical,
+		TxHash:    key,
+		Event:     enrichedWithdrawalEvent,
+		Timestamp: time.Now(),
+	})
 }
 
 func (s *State) IncrementPotentialAttackOnInProgressGames(enrichedWithdrawalEvent *validator.EnrichedProvenWithdrawalEvent) {
 	key := enrichedWithdrawalEvent.Event.Raw.TxHash
+
+	s.mu.Lock()
 	// check if key already exists
 	if _, ok := s.potentialAttackOnInProgressGames[key]; ok {
 		s.logger.Error("STATE WITHDRAWAL:is NOT valid, game is still in progress", "TxHash", fmt.Sprintf("%v", enrichedWithdrawalEvent.Event.Raw.TxHash), "enrichedWithdrawalEvent", enrichedWithdrawalEvent)
@@ -165,26 +291,63 @@ func (s *State) IncrementPotentialAttackOnInProgressGames(enrichedWithdrawalEven
 
 	// eventually update the map with the new enrichedWithdrawalEvent
 	s.potentialAttackOnInProgressGames[key] = enrichedWithdrawalEvent
+	s.mu.Unlock()
+
+	if err := s.store.UpsertEnrichedEvent(CategoryPotentialAttackOnInProgressGames, enrichedWithdrawalEvent); err != nil {
+		s.logger.Error("STATE WITHDRAWAL: failed to persist in-progress game", "TxHash", fmt.Sprintf("%v", key), "error", err)
+	}
+
+	s.alertDispatcher.Dispatch(AlertEvent{
+		Severity:  AlertSeverityWarning,
+		TxHash:    key,
+		Event:     enrichedWithdrawalEvent,
+		Timestamp: time.Now(),
+	})
 }
 
 func (s *State) IncrementSuspiciousEventsOnChallengerWinsGames(enrichedWithdrawalEvent *validator.EnrichedProvenWithdrawalEvent) {
 	key := enrichedWithdrawalEvent.Event.Raw.TxHash
 
 	s.logger.Error("STATE WITHDRAWAL:is NOT valid, but the game is correctly resolved", "TxHash", fmt.Sprintf("%v", enrichedWithdrawalEvent.Event.Raw.TxHash), "enrichedWithdrawalEvent", enrichedWithdrawalEvent)
+
+	s.mu.Lock()
 	s.suspiciousEventsOnChallengerWinsGames.Add(key, enrichedWithdrawalEvent)
 	s.numberOfSuspiciousEventsOnChallengerWinsGames++
-
 	if _, ok := s.potentialAttackOnInProgressGames[key]; ok {
 		s.logger.Error("STATE WITHDRAWAL: added to suspicious attacks. Removing from inProgress", "TxHash", fmt.Sprintf("%v", enrichedWithdrawalEvent.Event.Raw.TxHash), "enrichedWithdrawalEvent", enrichedWithdrawalEvent)
 		delete(s.potentialAttackOnInProgressGames, key)
 		s.numberOfPotentialAttackOnInProgressGames--
 	}
-
 	s.withdrawalsProcessed++
 	enrichedWithdrawalEvent.ProcessedTimeStamp = float64(time.Now().Unix())
+	s.mu.Unlock()
+
+	if err := s.store.UpsertEnrichedEvent(CategorySuspiciousEventsOnChallengerWinsGames, enrichedWithdrawalEvent); err != nil {
+		s.logger.Error("STATE WITHDRAWAL: failed to persist suspicious event", "TxHash", fmt.Sprintf("%v", key), "error", err)
+	}
+	if err := s.store.DeleteEvent(CategoryPotentialAttackOnInProgressGames, key); err != nil {
+		s.logger.Error("STATE WITHDRAWAL: failed to remove persisted in-progress event", "TxHash", fmt.Sprintf("%v", key), "error", err)
+	}
+
+	s.alertDispatcher.Dispatch(AlertEvent{
+		Severity:  AlertSeverityInfo,
+		TxHash:    key,
+		Event:     enrichedWithdrawalEvent,
+		Timestamp: time.Now(),
+	})
 }
 
+// GetPercentages returns how many L1 blocks remain to be scanned and what
+// percentage of the chain has been processed so far.
 func (s *State) GetPercentages() (uint64, uint64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.getPercentagesLocked()
+}
+
+// getPercentagesLocked is GetPercentages' body, factored out so callers that
+// already hold s.mu (e.g. LogState) don't re-acquire it.
+func (s *State) getPercentagesLocked() (uint64, uint64) {
 	blockToProcess := s.latestL1Height - s.nextL1Height
 	divisor := float64(s.latestL1Height) * 100
 	//checking to avoid division by 0
@@ -195,67 +358,41 @@ func (s *State) GetPercentages() (uint64, uint64) {
 	return blockToProcess, syncPercentage
 }
 
+// chainLabels are the Prometheus labels carried by every metric below. See
+// ChainMonitor for why one Metrics instance is shared across chains.
+var chainLabels = []string{"chain"}
+
 type Metrics struct {
-	UpGauge              prometheus.Gauge
-	InitialL1HeightGauge prometheus.Gauge
-	NextL1HeightGauge    prometheus.Gauge
-	LatestL1HeightGauge  prometheus.Gauge
-	LatestL2HeightGauge  prometheus.Gauge
+	UpGaugeVec              *prometheus.GaugeVec
+	InitialL1HeightGaugeVec *prometheus.GaugeVec
+	NextL1HeightGaugeVec    *prometheus.GaugeVec
+	LatestL1HeightGaugeVec  *prometheus.GaugeVec
+	LatestL2HeightGaugeVec  *prometheus.GaugeVec
 
-	EventsProcessedCounter      prometheus.Counter
-	WithdrawalsProcessedCounter prometheus.Counter
+	EventsProcessedCounterVec      *prometheus.CounterVec
+	WithdrawalsProcessedCounterVec *prometheus.CounterVec
 
-	NodeConnectionFailuresCounter              prometheus.Counter
-	NodeConnectionsCounter                     prometheus.Counter
-	PotentialAttackOnDefenderWinsGamesGauge    prometheus.Gauge
-	PotentialAttackOnInProgressGamesGauge      prometheus.Gauge
-	SuspiciousEventsOnChallengerWinsGamesGauge prometheus.Gauge
+	NodeConnectionFailuresCounterVec              *prometheus.CounterVec
+	NodeConnectionsCounterVec                     *prometheus.CounterVec
+	PotentialAttackOnDefenderWinsGamesCountVec    *prometheus.GaugeVec
+	PotentialAttackOnInProgressGamesCountVec      *prometheus.GaugeVec
+	SuspiciousEventsOnChallengerWinsGamesCountVec *prometheus.GaugeVec
 
 	PotentialAttackOnDefenderWinsGamesGaugeVec    *prometheus.GaugeVec
 	PotentialAttackOnInProgressGamesGaugeVec      *prometheus.GaugeVec
 	SuspiciousEventsOnChallengerWinsGamesGaugeVec *prometheus.GaugeVec
 
-	// Previous values for counters
-	previousEventsProcessed        uint64
-	previousWithdrawalsProcessed   uint64
-	previousNodeConnectionFailures uint64
-	previousNodeConnections        uint64
+	// Previous values for counters, keyed by chain, so deltas stay correct
+	// when multiple chains share this Metrics instance.
+	previousEventsProcessed        map[string]uint64
+	previousWithdrawalsProcessed   map[string]uint64
+	previousNodeConnectionFailures map[string]uint64
+	previousNodeConnections        map[string]uint64
 }
 
 func (m *Metrics) String() string {
-	upGaugeValue, _ := GetGaugeValue(m.UpGauge)
-	initialL1HeightGaugeValue, _ := GetGaugeValue(m.InitialL1HeightGauge)
-	nextL1HeightGaugeValue, _ := GetGaugeValue(m.NextL1HeightGauge)
-	latestL1HeightGaugeValue, _ := GetGaugeValue(m.LatestL1HeightGauge)
-	latestL2HeightGaugeValue, _ := GetGaugeValue(m.LatestL2HeightGauge)
-
-	withdrawalsProcessedCounterValue, _ := GetCounterValue(m.WithdrawalsProcessedCounter)
-	eventsProcessedCounterValue, _ := GetCounterValue(m.EventsProcessedCounter)
-
-	nodeConnectionFailuresCounterValue, _ := GetCounterValue(m.NodeConnectionFailuresCounter)
-	nodeConnectionsCounterValue, _ := GetCounterValue(m.NodeConnectionsCounter)
-
-	potentialAttackOnDefenderWinsGamesGaugeValue, _ := GetGaugeValue(m.PotentialAttackOnDefenderWinsGamesGauge)
-	potentialAttackOnInProgressGamesGaugeValue, _ := GetGaugeValue(m.PotentialAttackOnInProgressGamesGauge)
-
-	forgeriesWithdrawalsEventsGaugeVecValue, _ := GetGaugeVecValue(m.PotentialAttackOnDefenderWinsGamesGaugeVec, prometheus.Labels{})
-	invalidProposalWithdrawalsEventsGaugeVecValue, _ := GetGaugeVecValue(m.PotentialAttackOnInProgressGamesGaugeVec, prometheus.Labels{})
-
 	return fmt.Sprintf(
-		"Up: %d\nInitialL1HeightGauge: %d\nNextL1HeightGauge: %d\nLatestL1HeightGauge: %d\n latestL2HeightGaugeValue: %d\n eventsProcessedCounterValue: %d\nwithdrawalsProcessedCounterValue: %d\nnodeConnectionFailuresCounterValue: %d\nnodeConnectionsCounterValue: %d\n potentialAttackOnDefenderWinsGamesGaugeValue: %d\n potentialAttackOnInProgressGamesGaugeValue: %d\n  forgeriesWithdrawalsEventsGaugeVecValue: %d\n invalidProposalWithdrawalsEventsGaugeVecValue: %d\n previousEventsProcessed: %d\n previousWithdrawalsProcessed: %d\n previousNodeConnectionFailures: %d\n previousNodeConnections: %d\n",
-		uint64(upGaugeValue),
-		uint64(initialL1HeightGaugeValue),
-		uint64(nextL1HeightGaugeValue),
-		uint64(latestL1HeightGaugeValue),
-		uint64(latestL2HeightGaugeValue),
-		uint64(eventsProcessedCounterValue),
-		uint64(withdrawalsProcessedCounterValue),
-		uint64(nodeConnectionFailuresCounterValue),
-		uint64(nodeConnectionsCounterValue),
-		uint64(potentialAttackOnDefenderWinsGamesGaugeValue),
-		uint64(potentialAttackOnInProgressGamesGaugeValue),
-		uint64(forgeriesWithdrawalsEventsGaugeVecValue),
-		uint64(invalidProposalWithdrawalsEventsGaugeVecValue),
+		"previousEventsProcessed: %v\n previousWithdrawalsProcessed: %v\n previousNodeConnectionFailures: %v\n previousNodeConnections: %v\n",
 		m.previousEventsProcessed,
 		m.previousWithdrawalsProcessed,
 		m.previousNodeConnectionFailures,
@@ -299,74 +436,78 @@ func GetGaugeVecValue(gaugeVec *prometheus.GaugeVec, labels prometheus.Labels) (
 }
 
 func NewMetrics(m metrics.Factory) *Metrics {
+	withChainLabel := func(labels []string) []string {
+		return append(append([]string{}, chainLabels...), labels...)
+	}
+
 	ret := &Metrics{
-		UpGauge: m.NewGauge(prometheus.GaugeOpts{
+		UpGaugeVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "up",
 			Help:      "1 if the service is up and running, 0 otherwise",
-		}),
-		InitialL1HeightGauge: m.NewGauge(prometheus.GaugeOpts{
+		}, chainLabels),
+		InitialL1HeightGaugeVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "initial_l1_height",
 			Help:      "Initial L1 Height",
-		}),
-		NextL1HeightGauge: m.NewGauge(prometheus.GaugeOpts{
+		}, chainLabels),
+		NextL1HeightGaugeVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "next_l1_height",
 			Help:      "Next L1 Height",
-		}),
-		LatestL1HeightGauge: m.NewGauge(prometheus.GaugeOpts{
+		}, chainLabels),
+		LatestL1HeightGaugeVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "latest_l1_height",
 			Help:      "Latest L1 Height",
-		}),
-		LatestL2HeightGauge: m.NewGauge(prometheus.GaugeOpts{
+		}, chainLabels),
+		LatestL2HeightGaugeVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "latest_l2_height",
 			Help:      "Latest L2 Height",
-		}),
-		EventsProcessedCounter: m.NewCounter(prometheus.CounterOpts{
+		}, chainLabels),
+		EventsProcessedCounterVec: m.NewCounterVec(prometheus.CounterOpts{
 			Namespace: MetricsNamespace,
 			Name:      "events_processed_total",
 			Help:      "Total number of events processed",
-		}),
-		WithdrawalsProcessedCounter: m.NewCounter(prometheus.CounterOpts{
+		}, chainLabels),
+		WithdrawalsProcessedCounterVec: m.NewCounterVec(prometheus.CounterOpts{
 			Namespace: MetricsNamespace,
 			Name:      "withdrawals_processed_total",
 			Help:      "Total number of withdrawals processed",
-		}),
-		NodeConnectionFailuresCounter: m.NewCounter(prometheus.CounterOpts{
+		}, chainLabels),
+		NodeConnectionFailuresCounterVec: m.NewCounterVec(prometheus.CounterOpts{
 			Namespace: MetricsNamespace,
 			Name:      "node_connection_failures_total",
 			Help:      "Total number of node connection failures",
-		}),
-		NodeConnectionsCounter: m.NewCounter(prometheus.CounterOpts{
+		}, chainLabels),
+		NodeConnectionsCounterVec: m.NewCounterVec(prometheus.CounterOpts{
 			Namespace: MetricsNamespace,
 			Name:      "node_connections_total",
 			Help:      "Total number of node connections",
-		}),
-		PotentialAttackOnDefenderWinsGamesGauge: m.NewGauge(prometheus.GaugeOpts{
+		}, chainLabels),
+		PotentialAttackOnDefenderWinsGamesCountVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "potential_attack_on_defender_wins_games_count",
 			Help:      "Number of potential attacks on defender wins games",
-		}),
-		PotentialAttackOnInProgressGamesGauge: m.NewGauge(prometheus.GaugeOpts{
+		}, chainLabels),
+		PotentialAttackOnInProgressGamesCountVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "potential_attack_on_in_progress_games_count",
 			Help:      "Number of potential attacks on in progress games",
-		}),
-		SuspiciousEventsOnChallengerWinsGamesGauge: m.NewGauge(prometheus.GaugeOpts{
+		}, chainLabels),
+		SuspiciousEventsOnChallengerWinsGamesCountVec: m.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: MetricsNamespace,
 			Name:      "suspicious_events_on_challenger_wins_games_count",
 			Help:      "Number of suspicious events on challenger wins games",
-		}),
+		}, chainLabels),
 		PotentialAttackOnDefenderWinsGamesGaugeVec: m.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Namespace: MetricsNamespace,
 				Name:      "potential_attack_on_defender_wins_games_gauge_vec",
 				Help:      "Information about potential attacks on defender wins games.",
 			},
-			[]string{"withdrawal_hash", "proof_submitter", "status", "TxHash", "TxL1BlockNumber", "ProxyAddress", "L2blockNumber", "RootClaim", "blacklisted", "withdrawal_hash_present", "enriched", "event_block_number", "event_tx_hash"},
+			withChainLabel([]string{"withdrawal_hash", "proof_submitter", "status", "TxHash", "TxL1BlockNumber", "ProxyAddress", "L2blockNumber", "RootClaim", "blacklisted", "withdrawal_hash_present", "enriched", "event_block_number", "event_tx_hash"}),
 		),
 		PotentialAttackOnInProgressGamesGaugeVec: m.NewGaugeVec(
 			prometheus.GaugeOpts{
@@ -374,7 +515,7 @@ func NewMetrics(m metrics.Factory) *Metrics {
 				Name:      "potential_attack_on_in_progress_games_gauge_vec",
 				Help:      "Information about potential attacks on in progress games.",
 			},
-			[]string{"withdrawal_hash", "proof_submitter", "status", "TxHash", "TxL1BlockNumber", "ProxyAddress", "L2blockNumber", "RootClaim", "blacklisted", "withdrawal_hash_present", "enriched", "event_block_number", "event_tx_hash"},
+			withChainLabel([]string{"withdrawal_hash", "proof_submitter", "status", "TxHash", "TxL1BlockNumber", "ProxyAddress", "L2blockNumber", "RootClaim", "blacklisted", "withdrawal_hash_present", "enriched", "event_block_number", "event_tx_hash"}),
 		),
 		SuspiciousEventsOnChallengerWinsGamesGaugeVec: m.NewGaugeVec(
 			prometheus.GaugeOpts{
@@ -382,58 +523,72 @@ func NewMetrics(m metrics.Factory) *Metrics {
 				Name:      "suspicious_events_on_challenger_wins_games_info",
 				Help:      "Information about suspicious events on challenger wins games.",
 			},
-			[]string{"withdrawal_hash", "proof_submitter", "status", "TxHash", "TxL1BlockNumber", "ProxyAddress", "L2blockNumber", "RootClaim", "blacklisted", "withdrawal_hash_present", "enriched", "event_block_number", "event_tx_hash"},
+			withChainLabel([]string{"withdrawal_hash", "proof_submitter", "status", "TxHash", "TxL1BlockNumber", "ProxyAddress", "L2blockNumber", "RootClaim", "blacklisted", "withdrawal_hash_present", "enriched", "event_block_number", "event_tx_hash"}),
 		),
+
+		previousEventsProcessed:        make(map[string]uint64),
+		previousWithdrawalsProcessed:   make(map[string]uint64),
+		previousNodeConnectionFailures: make(map[string]uint64),
+		previousNodeConnections:        make(map[string]uint64),
 	}
 
 	return ret
 }
 
+// UpdateMetricsFromState refreshes every metric's "chain"-labeled series for
+// state.GetChainName(), leaving every other chain's series untouched. A
+// process monitoring N chains calls this once per chain, e.g. from
+// ChainMonitor.UpdateMetrics.
 func (m *Metrics) UpdateMetricsFromState(state *State) {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	chain := state.GetChainName()
+	chainLabelValues := prometheus.Labels{"chain": chain}
 
 	// Set the up gauge to 1 to indicate the service is running
-	m.UpGauge.Set(1)
+	m.UpGaugeVec.With(chainLabelValues).Set(1)
 
 	// Update Gauges
-	m.InitialL1HeightGauge.Set(float64(state.initialL1Height))
-	m.NextL1HeightGauge.Set(float64(state.nextL1Height))
-	m.LatestL1HeightGauge.Set(float64(state.latestL1Height))
-	m.LatestL2HeightGauge.Set(float64(state.latestL2Height))
+	m.InitialL1HeightGaugeVec.With(chainLabelValues).Set(float64(state.initialL1Height))
+	m.NextL1HeightGaugeVec.With(chainLabelValues).Set(float64(state.nextL1Height))
+	m.LatestL1HeightGaugeVec.With(chainLabelValues).Set(float64(state.latestL1Height))
+	m.LatestL2HeightGaugeVec.With(chainLabelValues).Set(float64(state.latestL2Height))
 
-	m.PotentialAttackOnDefenderWinsGamesGauge.Set(float64(state.numberOfPotentialAttacksOnDefenderWinsGames))
-	m.PotentialAttackOnInProgressGamesGauge.Set(float64(state.numberOfPotentialAttackOnInProgressGames))
-	m.SuspiciousEventsOnChallengerWinsGamesGauge.Set(float64(state.numberOfSuspiciousEventsOnChallengerWinsGames))
+	m.PotentialAttackOnDefenderWinsGamesCountVec.With(chainLabelValues).Set(float64(state.numberOfPotentialAttacksOnDefenderWinsGames))
+	m.PotentialAttackOnInProgressGamesCountVec.With(chainLabelValues).Set(float64(state.numberOfPotentialAttackOnInProgressGames))
+	m.SuspiciousEventsOnChallengerWinsGamesCountVec.With(chainLabelValues).Set(float64(state.numberOfSuspiciousEventsOnChallengerWinsGames))
 
 	// Update Counters by calculating deltas
 	// Processed Withdrawals
-	eventsProcessedDelta := state.eventsProcessed - m.previousEventsProcessed
+	eventsProcessedDelta := state.eventsProcessed - m.previousEventsProcessed[chain]
 	if eventsProcessedDelta > 0 {
-		m.EventsProcessedCounter.Add(float64(eventsProcessedDelta))
+		m.EventsProcessedCounterVec.With(chainLabelValues).Add(float64(eventsProcessedDelta))
 	}
-	m.previousEventsProcessed = state.eventsProcessed
+	m.previousEventsProcessed[chain] = state.eventsProcessed
 
 	// Withdrawals Validated
-	withdrawalsProcessedDelta := state.withdrawalsProcessed - m.previousWithdrawalsProcessed
+	withdrawalsProcessedDelta := state.withdrawalsProcessed - m.previousWithdrawalsProcessed[chain]
 	if withdrawalsProcessedDelta > 0 {
-		m.WithdrawalsProcessedCounter.Add(float64(withdrawalsProcessedDelta))
+		m.WithdrawalsProcessedCounterVec.With(chainLabelValues).Add(float64(withdrawalsProcessedDelta))
 	}
-	m.previousWithdrawalsProcessed = state.withdrawalsProcessed
+	m.previousWithdrawalsProcessed[chain] = state.withdrawalsProcessed
 
 	// Node Connection Failures
-	nodeConnectionFailuresDelta := state.GetNodeConnectionFailures() - m.previousNodeConnectionFailures
+	nodeConnectionFailuresDelta := state.GetNodeConnectionFailures() - m.previousNodeConnectionFailures[chain]
 	if nodeConnectionFailuresDelta > 0 {
-		m.NodeConnectionFailuresCounter.Add(float64(nodeConnectionFailuresDelta))
+		m.NodeConnectionFailuresCounterVec.With(chainLabelValues).Add(float64(nodeConnectionFailuresDelta))
 	}
-	m.previousNodeConnectionFailures = state.GetNodeConnectionFailures()
+	m.previousNodeConnectionFailures[chain] = state.GetNodeConnectionFailures()
 
-	nodeConnectionsDelta := state.GetNodeConnections() - m.previousNodeConnections
+	nodeConnectionsDelta := state.GetNodeConnections() - m.previousNodeConnections[chain]
 	if nodeConnectionsDelta > 0 {
-		m.NodeConnectionsCounter.Add(float64(nodeConnectionsDelta))
+		m.NodeConnectionsCounterVec.With(chainLabelValues).Add(float64(nodeConnectionsDelta))
 	}
-	m.previousNodeConnections = state.GetNodeConnections()
+	m.previousNodeConnections[chain] = state.GetNodeConnections()
 
-	// Clear the previous values
-	m.PotentialAttackOnDefenderWinsGamesGaugeVec.Reset()
+	// Clear this chain's previous values, leaving other chains' series alone
+	m.PotentialAttackOnDefenderWinsGamesGaugeVec.DeletePartialMatch(chainLabelValues)
 
 	// Update metrics for forgeries withdrawals events
 	for _, event := range state.potentialAttackOnDefenderWinsGames {
@@ -442,6 +597,7 @@ func (m *Metrics) UpdateMetricsFromState(state *State) {
 		status := event.DisputeGame.DisputeGameData.Status.String()
 
 		m.PotentialAttackOnDefenderWinsGamesGaugeVec.WithLabelValues(
+			chain,
 			withdrawalHash,
 			proofSubmitter,
 			status,
@@ -458,8 +614,8 @@ func (m *Metrics) UpdateMetricsFromState(state *State) {
 		).Set(event.ProcessedTimeStamp) // Set the timestamp of when the event was processed
 	}
 
-	// Clear the previous values
-	m.PotentialAttackOnInProgressGamesGaugeVec.Reset()
+	// Clear this chain's previous values, leaving other chains' series alone
+	m.PotentialAttackOnInProgressGamesGaugeVec.DeletePartialMatch(chainLabelValues)
 
 	// Update metrics for invalid proposal withdrawals events
 	for _, event := range state.potentialAttackOnInProgressGames {
@@ -468,6 +624,7 @@ func (m *Metrics) UpdateMetricsFromState(state *State) {
 		status := event.DisputeGame.DisputeGameData.Status.String()
 
 		m.PotentialAttackOnInProgressGamesGaugeVec.WithLabelValues(
+			chain,
 			withdrawalHash,
 			proofSubmitter,
 			status,
@@ -484,8 +641,8 @@ func (m *Metrics) UpdateMetricsFromState(state *State) {
 		).Set(event.ProcessedTimeStamp) // Set the timestamp of when the event was processed
 	}
 
-	// Clear the previous values
-	m.SuspiciousEventsOnChallengerWinsGamesGaugeVec.Reset()
+	// Clear this chain's previous values, leaving other chains' series alone
+	m.SuspiciousEventsOnChallengerWinsGamesGaugeVec.DeletePartialMatch(chainLabelValues)
 	// Update metrics for invalid proposal withdrawals events
 	for _, key := range state.suspiciousEventsOnChallengerWinsGames.Keys() {
 		enrichedEvent, ok := state.suspiciousEventsOnChallengerWinsGames.Get(key)
@@ -496,6 +653,7 @@ func (m *Metrics) UpdateMetricsFromState(state *State) {
 			status := event.DisputeGame.DisputeGameData.Status.String()
 
 			m.SuspiciousEventsOnChallengerWinsGamesGaugeVec.WithLabelValues(
+				chain,
 				withdrawalHash,
 				proofSubmitter,
 				status,
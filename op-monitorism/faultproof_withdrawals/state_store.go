@@ -0,0 +1,212 @@
+package faultproof_withdrawals
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/ethereum-optimism/monitorism/op-monitorism/faultproof_withdrawals/validator"
+	"github.com/ethereum/go-ethereum/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// EventCategory identifies which in-memory map an enriched withdrawal event
+// belongs to, so a StateStore can keep its own on-disk bucketing in sync with
+// State without needing to know about State's internals.
+type EventCategory int
+
+const (
+	CategoryPotentialAttackOnDefenderWinsGames EventCategory = iota
+	CategoryPotentialAttackOnInProgressGames
+	CategorySuspiciousEventsOnChallengerWinsGames
+)
+
+// StateStore persists everything State needs to resume monitoring after a
+// restart: the L1 cursor and the set of withdrawal events that have not
+// finished being tracked yet. Implementations must be safe for concurrent
+// use.
+type StateStore interface {
+	// LoadCursor returns the last persisted nextL1Height. found is false if
+	// nothing has been persisted yet, in which case State falls back to the
+	// chain's current head.
+	LoadCursor() (height uint64, found bool, err error)
+	SaveCursor(height uint64) error
+
+	UpsertEnrichedEvent(category EventCategory, event *validator.EnrichedProvenWithdrawalEvent) error
+	DeleteEvent(category EventCategory, txHash common.Hash) error
+
+	// LoadOpenEvents returns every persisted event, keyed by category, so
+	// State can hydrate its maps on startup. Only events that still require
+	// monitoring (i.e. have not been deleted via DeleteEvent) are returned.
+	LoadOpenEvents() (map[EventCategory]map[common.Hash]*validator.EnrichedProvenWithdrawalEvent, error)
+
+	Close() error
+}
+
+// nullStateStore is the zero-value StateStore: it persists nothing, so State
+// behaves exactly as it did before StateStore existed. It is the default
+// used when NewState is called without WithStateStore.
+type nullStateStore struct{}
+
+func (nullStateStore) LoadCursor() (uint64, bool, error) { return 0, false, nil }
+
+func (nullStateStore) SaveCursor(uint64) error { return nil }
+
+func (nullStateStore) UpsertEnrichedEvent(EventCategory, *validator.EnrichedProvenWithdrawalEvent) error {
+	return nil
+}
+func (nullStateStore) DeleteEvent(EventCategory, common.Hash) error { return nil }
+func (nullStateStore) LoadOpenEvents() (map[EventCategory]map[common.Hash]*validator.EnrichedProvenWithdrawalEvent, error) {
+	return nil, nil
+}
+func (nullStateStore) Close() error { return nil }
+
+var (
+	cursorBucket = []byte("cursor")
+	cursorKey    = []byte("nextL1Height")
+
+	categoryBuckets = map[EventCategory][]byte{
+		CategoryPotentialAttackOnDefenderWinsGames:    []byte("potentialAttackOnDefenderWinsGames"),
+		CategoryPotentialAttackOnInProgressGames:      []byte("potentialAttackOnInProgressGames"),
+		CategorySuspiciousEventsOnChallengerWinsGames: []byte("suspiciousEventsOnChallengerWinsGames"),
+	}
+)
+
+// BoltStateStore is the default on-disk StateStore, backed by a single
+// bbolt file. NewState only uses it when it is passed in via
+// WithStateStore; without that option NewState uses nullStateStore.
+type BoltStateStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStateStore opens (creating if necessary) a bbolt database at path
+// and ensures the buckets used to track cursors and open events exist.
+func NewBoltStateStore(path string) (*BoltStateStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open state store at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(cursorBucket); err != nil {
+			return err
+		}
+		for _, bucket := range categoryBuckets {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize state store buckets: %w", err)
+	}
+
+	return &BoltStateStore{db: db}, nil
+}
+
+func (s *BoltStateStore) LoadCursor() (uint64, bool, error) {
+	var height uint64
+	var found bool
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(cursorBucket).Get(cursorKey)
+		if value == nil {
+			return nil
+		}
+		found = true
+		height = binary.BigEndian.Uint64(value)
+		return nil
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to load cursor: %w", err)
+	}
+
+	return height, found, nil
+}
+
+func (s *BoltStateStore) SaveCursor(height uint64) error {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, height)
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(cursorBucket).Put(cursorKey, value)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save cursor: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStateStore) UpsertEnrichedEvent(category EventCategory, event *validator.EnrichedProvenWithdrawalEvent) error {
+	bucket, ok := categoryBuckets[category]
+	if !ok {
+		return fmt.Errorf("unknown event category: %d", category)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(event); err != nil {
+		return fmt.Errorf("failed to encode enriched event: %w", err)
+	}
+
+	key := event.Event.Raw.TxHash
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(key.Bytes(), buf.Bytes())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert enriched event: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStateStore) DeleteEvent(category EventCategory, txHash common.Hash) error {
+	bucket, ok := categoryBuckets[category]
+	if !ok {
+		return fmt.Errorf("unknown event category: %d", category)
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Delete(txHash.Bytes())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete enriched event: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStateStore) LoadOpenEvents() (map[EventCategory]map[common.Hash]*validator.EnrichedProvenWithdrawalEvent, error) {
+	result := make(map[EventCategory]map[common.Hash]*validator.EnrichedProvenWithdrawalEvent, len(categoryBuckets))
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		for category, bucketName := range categoryBuckets {
+			events := make(map[common.Hash]*validator.EnrichedProvenWithdrawalEvent)
+
+			err := tx.Bucket(bucketName).ForEach(func(k, v []byte) error {
+				var event validator.EnrichedProvenWithdrawalEvent
+				if err := gob.NewDecoder(bytes.NewReader(v)).Decode(&event); err != nil {
+					return fmt.Errorf("failed to decode enriched event for key %x: %w", k, err)
+				}
+				events[common.BytesToHash(k)] = &event
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			result[category] = events
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load open events: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *BoltStateStore) Close() error {
+	return s.db.Close()
+}
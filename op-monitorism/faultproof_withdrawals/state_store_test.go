@@ -0,0 +1,120 @@
+package faultproof_withdrawals
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/monitorism/op-monitorism/faultproof_withdrawals/validator"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func newTestEnrichedEvent(txHash common.Hash, blockNumber uint64) *validator.EnrichedProvenWithdrawalEvent {
+	return &validator.EnrichedProvenWithdrawalEvent{
+		Event: &validator.ProvenWithdrawalEvent{
+			Raw: types.Log{TxHash: txHash, BlockNumber: blockNumber},
+		},
+		ProcessedTimeStamp: float64(blockNumber),
+	}
+}
+
+// TestBoltStateStoreRoundTrip exercises SaveCursor/LoadCursor and
+// Upsert/DeleteEvent/LoadOpenEvents through an on-disk BoltStateStore, the
+// same path hydrateFromStore relies on to resume monitoring after a restart.
+func TestBoltStateStoreRoundTrip(t *testing.T) {
+	store, err := NewBoltStateStore(filepath.Join(t.TempDir(), "state.db"))
+	if err != nil {
+		t.Fatalf("failed to open state store: %v", err)
+	}
+	defer store.Close()
+
+	if _, found, err := store.LoadCursor(); err != nil {
+		t.Fatalf("LoadCursor returned an error before anything was saved: %v", err)
+	} else if found {
+		t.Fatalf("expected LoadCursor to report not found before SaveCursor is ever called")
+	}
+
+	if err := store.SaveCursor(42); err != nil {
+		t.Fatalf("failed to save cursor: %v", err)
+	}
+	height, found, err := store.LoadCursor()
+	if err != nil {
+		t.Fatalf("failed to load cursor: %v", err)
+	}
+	if !found || height != 42 {
+		t.Fatalf("expected cursor 42, found=true, got cursor %d, found=%v", height, found)
+	}
+
+	inProgressHash := common.HexToHash("0x1")
+	defenderWinsHash := common.HexToHash("0x2")
+
+	if err := store.UpsertEnrichedEvent(CategoryPotentialAttackOnInProgressGames, newTestEnrichedEvent(inProgressHash, 1)); err != nil {
+		t.Fatalf("failed to upsert in-progress event: %v", err)
+	}
+	if err := store.UpsertEnrichedEvent(CategoryPotentialAttackOnDefenderWinsGames, newTestEnrichedEvent(defenderWinsHash, 2)); err != nil {
+		t.Fatalf("failed to upsert defender-wins event: %v", err)
+	}
+
+	openEvents, err := store.LoadOpenEvents()
+	if err != nil {
+		t.Fatalf("failed to load open events: %v", err)
+	}
+	if _, ok := openEvents[CategoryPotentialAttackOnInProgressGames][inProgressHash]; !ok {
+		t.Fatalf("expected in-progress event %s to be persisted", inProgressHash)
+	}
+	if _, ok := openEvents[CategoryPotentialAttackOnDefenderWinsGames][defenderWinsHash]; !ok {
+		t.Fatalf("expected defender-wins event %s to be persisted", defenderWinsHash)
+	}
+
+	// Once a game resolves, the in-progress event is removed from the store,
+	// mirroring what IncrementPotentialAttackOnDefenderWinsGames does.
+	if err := store.DeleteEvent(CategoryPotentialAttackOnInProgressGames, inProgressHash); err != nil {
+		t.Fatalf("failed to delete in-progress event: %v", err)
+	}
+
+	openEvents, err = store.LoadOpenEvents()
+	if err != nil {
+		t.Fatalf("failed to load open events after delete: %v", err)
+	}
+	if _, ok := openEvents[CategoryPotentialAttackOnInProgressGames][inProgressHash]; ok {
+		t.Fatalf("expected in-progress event %s to be gone after DeleteEvent", inProgressHash)
+	}
+	if _, ok := openEvents[CategoryPotentialAttackOnDefenderWinsGames][defenderWinsHash]; !ok {
+		t.Fatalf("expected defender-wins event %s to remain after unrelated delete", defenderWinsHash)
+	}
+}
+
+// TestStateHydrateFromStore verifies that a State constructed with a store
+// already holding a cursor and open events resumes from them instead of
+// starting over from the chain's current head.
+func TestStateHydrateFromStore(t *testing.T) {
+	store, err := NewBoltStateStore(filepath.Join(t.TempDir(), "state.db"))
+	if err != nil {
+		t.Fatalf("failed to open state store: %v", err)
+	}
+	defer store.Close()
+
+	if err := store.SaveCursor(100); err != nil {
+		t.Fatalf("failed to save cursor: %v", err)
+	}
+
+	inProgressHash := common.HexToHash("0x3")
+	if err := store.UpsertEnrichedEvent(CategoryPotentialAttackOnInProgressGames, newTestEnrichedEvent(inProgressHash, 100)); err != nil {
+		t.Fatalf("failed to upsert in-progress event: %v", err)
+	}
+
+	s := &State{store: store}
+	if err := s.hydrateFromStore(); err != nil {
+		t.Fatalf("hydrateFromStore returned an error: %v", err)
+	}
+
+	if s.nextL1Height != 100 {
+		t.Fatalf("expected nextL1Height to resume from the persisted cursor 100, got %d", s.nextL1Height)
+	}
+	if _, ok := s.potentialAttackOnInProgressGames[inProgressHash]; !ok {
+		t.Fatalf("expected in-progress event %s to be loaded into State", inProgressHash)
+	}
+	if s.numberOfPotentialAttackOnInProgressGames != 1 {
+		t.Fatalf("expected numberOfPotentialAttackOnInProgressGames to be 1, got %d", s.numberOfPotentialAttackOnInProgressGames)
+	}
+}